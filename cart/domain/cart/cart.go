@@ -43,6 +43,17 @@ type (
 		AuthenticatedUserID        string
 
 		AppliedCouponCodes []CouponCode
+
+		//AppliedGiftCards - list of gift cards that have been (partially) redeemed against this cart
+		AppliedGiftCards []AppliedGiftCard
+
+		//DisplayCurrency - the currency CartTotals are presented to the customer in. Item.SourcePrice may
+		//be held in a different currency (e.g. a merchant selling cross-border in USD to EUR customers) -
+		//empty means the cart only ever deals in a single, implicit currency
+		DisplayCurrency string
+		//FXRates - the source currency to DisplayCurrency conversion rates known to this cart, keyed by
+		//the source currency code (e.g. "USD")
+		FXRates map[string]domain.Rate
 	}
 
 	// Teaser - represents some teaser infos for cart
@@ -84,11 +95,15 @@ type (
 		//DeliveryInfo - The details for this delivery - normaly completed during checkout
 		DeliveryInfo DeliveryInfo
 		//Cartitems - list of cartitems
-		Cartitems      []Item
+		Cartitems []Item
 		//DeliveryTotals - Totals with the intent to use them to display the customer summary costs for this delivery
 		DeliveryTotals DeliveryTotals
 		//ShippingItem	- The Shipping Costs that may be involved in this delivery
-		ShippingItem   ShippingItem
+		ShippingItem ShippingItem
+		//AvailableRates - the carrier rate quotes returned by the ShippingRateProvider for this delivery
+		AvailableRates []ShippingRateQuote
+		//SelectedRateToken - the Token of the AvailableRates entry that was applied to ShippingItem
+		SelectedRateToken string
 	}
 
 	// DeliveryInfo - represents the Delivery
@@ -101,13 +116,13 @@ type (
 		//Method - The shippingmethod something that is project specific and that can mean different delivery qualities with different deliverycosts
 		Method string
 		//Carrier - Optional the name of the Carrier that should be responsible for executing the delivery
-		Carrier                 string
+		Carrier string
 		//DeliveryLocation The target Location for the delivery
-		DeliveryLocation        DeliveryLocation
+		DeliveryLocation DeliveryLocation
 		//DesiredTime - Optional - the desired time of the delivery
-		DesiredTime             time.Time
+		DesiredTime time.Time
 		//AdditionalData  - Possibility for key value based information on the delivery - can be used flexible by each project
-		AdditionalData          map[string]string
+		AdditionalData map[string]string
 		//AdditionalDeliveryInfos - similar to AdditionalData this can be used to store "any" other object on a delivery encoded as json.RawMessage
 		AdditionalDeliveryInfos map[string]json.RawMessage
 	}
@@ -186,6 +201,10 @@ type (
 
 		AdditionalData map[string]string
 
+		//SourcePrice - the single item price in the currency it was originally sourced in (e.g. the
+		//product's USD list price). It is legal for this to differ from SinglePrice.Currency - Cart.FXRates
+		//holds the rate used to convert it into Cart.DisplayCurrency
+		SourcePrice domain.Price
 		//brutto for single item
 		SinglePrice domain.Price
 		//netto for single item
@@ -243,7 +262,6 @@ type (
 
 		TaxAmount      domain.Price
 		DiscountAmount domain.Price
-
 	}
 
 	// InvalidateCartEvent value object
@@ -270,6 +288,8 @@ type (
 	PlacedOrderInfo struct {
 		OrderNumber  string
 		DeliveryCode string
+		//PlacedAt is the point in time the order was placed, e.g. rendered as cbc:IssueDate on an e-invoice
+		PlacedAt time.Time
 	}
 )
 
@@ -284,7 +304,7 @@ const (
 	DeliveryWorkflowDelivery    = "delivery"
 	DeliveryWorkflowUnspecified = "unspecified"
 
-	DeliverylocationTypeUnspecified = "unspecified"
+	DeliverylocationTypeUnspecified     = "unspecified"
 	DeliverylocationTypeCollectionpoint = "collection-point"
 	DeliverylocationTypeStore           = "store"
 	DeliverylocationTypeAddress         = "address"
@@ -292,6 +312,7 @@ const (
 
 	TotalsTypeDiscount      = "totals_type_discount"
 	TotalsTypeVoucher       = "totals_type_voucher"
+	TotalsTypeGiftcard      = "totals_type_giftcard"
 	TotalsTypeTax           = "totals_type_tax"
 	TotalsTypeLoyaltypoints = "totals_loyaltypoints"
 	TotalsTypeShipping      = "totals_type_shipping"
@@ -490,7 +511,7 @@ func (poi PlacedOrderInfos) GetOrderNumberForDeliveryCode(deliveryCode string) s
 	return ""
 }
 
-//LoadAdditionalInfo - returns the additional Data
+// LoadAdditionalInfo - returns the additional Data
 func (d *DeliveryInfo) LoadAdditionalInfo(key string, info AdditionalDeliverInfo) error {
 	if d.AdditionalDeliveryInfos == nil {
 		return ErrAdditionalInfosNotFound
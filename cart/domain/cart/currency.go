@@ -0,0 +1,85 @@
+package cart
+
+import (
+	"time"
+
+	"flamingo.me/flamingo-commerce/v3/price/domain"
+	"github.com/pkg/errors"
+)
+
+type (
+	// CurrencyConverter is a secondary port that converts a Price from its source currency into a
+	// target currency. Implementations range from a fixed-rate in-memory adapter for local development
+	// up to adapters backed by a live rate source such as the ECB reference rates or OpenExchangeRates
+	CurrencyConverter interface {
+		Convert(price domain.Price, target string) (domain.Price, error)
+	}
+)
+
+// FXRateMaxAge is the maximum age a Cart.FXRates entry may have before
+// Cart.ValidateCurrencyConsistency considers it stale. Projects with a more/less volatile FX feed can
+// override this default
+var FXRateMaxAge = 24 * time.Hour
+
+// ErrFXRateMissing is returned by ValidateCurrencyConsistency when an Item.SourcePrice is held in a
+// currency that has no matching Cart.FXRates entry
+var ErrFXRateMissing = errors.New("no FX rate configured for currency")
+
+// ErrFXRateStale is returned by ValidateCurrencyConsistency when a Cart.FXRates entry required to
+// express the cart in DisplayCurrency is older than FXRateMaxAge
+var ErrFXRateStale = errors.New("FX rate is stale")
+
+// ValidateCurrencyConsistency checks that every Item.SourcePrice whose currency differs from
+// DisplayCurrency has a corresponding, fresh entry in FXRates. Mixing currencies across items is legal -
+// this only guards against CartTotals.GrandTotal being derived from an outdated rate
+func (Cart Cart) ValidateCurrencyConsistency() error {
+	if Cart.DisplayCurrency == "" {
+		return nil
+	}
+
+	for _, delivery := range Cart.Deliveries {
+		for _, item := range delivery.Cartitems {
+			sourceCurrency := item.SourcePrice.Currency
+			if sourceCurrency == "" || sourceCurrency == Cart.DisplayCurrency {
+				continue
+			}
+
+			rate, ok := Cart.FXRates[sourceCurrency]
+			if !ok {
+				return errors.Wrapf(ErrFXRateMissing, "currency %q", sourceCurrency)
+			}
+
+			if time.Since(rate.AsOf) > FXRateMaxAge {
+				return errors.Wrapf(ErrFXRateStale, "currency %q, last updated %s", sourceCurrency, rate.AsOf)
+			}
+		}
+	}
+
+	return nil
+}
+
+// convertToDisplayCurrency converts price into Cart.DisplayCurrency, preferring the given converter
+// (e.g. a live ECB/OpenExchangeRates adapter) and falling back to the snapshotted Cart.FXRates when
+// converter is nil. Carts that don't use DisplayCurrency (the zero value) pass every price through
+// unchanged, preserving the behaviour of single-currency carts
+func (Cart Cart) convertToDisplayCurrency(converter CurrencyConverter, price domain.Price) (domain.Price, error) {
+	if Cart.DisplayCurrency == "" || price.Currency == "" || price.Currency == Cart.DisplayCurrency {
+		return price, nil
+	}
+
+	if converter != nil {
+		converted, err := converter.Convert(price, Cart.DisplayCurrency)
+		if err != nil {
+			return domain.Price{}, errors.Wrapf(err, "converting currency %q to %q", price.Currency, Cart.DisplayCurrency)
+		}
+
+		return converted, nil
+	}
+
+	rate, ok := Cart.FXRates[price.Currency]
+	if !ok {
+		return domain.Price{}, errors.Wrapf(ErrFXRateMissing, "currency %q", price.Currency)
+	}
+
+	return domain.NewFromFloat(price.FloatAmount()*rate.Rate, Cart.DisplayCurrency), nil
+}
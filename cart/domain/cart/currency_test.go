@@ -0,0 +1,131 @@
+package cart
+
+import (
+	"testing"
+	"time"
+
+	"flamingo.me/flamingo-commerce/v3/price/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+type fixedConverter struct {
+	rate float64
+	err  error
+}
+
+func (f fixedConverter) Convert(price domain.Price, target string) (domain.Price, error) {
+	if f.err != nil {
+		return domain.Price{}, f.err
+	}
+
+	return domain.NewFromFloat(price.FloatAmount()*f.rate, target), nil
+}
+
+func TestCart_convertToDisplayCurrency(t *testing.T) {
+	t.Run("passes through when DisplayCurrency is unset", func(t *testing.T) {
+		cart := Cart{}
+		price := domain.NewFromFloat(10, "USD")
+
+		result, err := cart.convertToDisplayCurrency(nil, price)
+
+		assert.NoError(t, err)
+		assert.Equal(t, price, result)
+	})
+
+	t.Run("passes through when price is already in DisplayCurrency", func(t *testing.T) {
+		cart := Cart{DisplayCurrency: "EUR"}
+		price := domain.NewFromFloat(10, "EUR")
+
+		result, err := cart.convertToDisplayCurrency(nil, price)
+
+		assert.NoError(t, err)
+		assert.Equal(t, price, result)
+	})
+
+	t.Run("prefers an injected CurrencyConverter over FXRates", func(t *testing.T) {
+		cart := Cart{
+			DisplayCurrency: "EUR",
+			FXRates:         map[string]domain.Rate{"USD": {Rate: 0.5, AsOf: time.Now()}},
+		}
+		converter := fixedConverter{rate: 0.9}
+
+		result, err := cart.convertToDisplayCurrency(converter, domain.NewFromFloat(10, "USD"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "EUR", result.Currency)
+		assert.InDelta(t, 9, result.FloatAmount(), 0.001)
+	})
+
+	t.Run("falls back to FXRates when no converter is given", func(t *testing.T) {
+		cart := Cart{
+			DisplayCurrency: "EUR",
+			FXRates:         map[string]domain.Rate{"USD": {Rate: 0.8, AsOf: time.Now()}},
+		}
+
+		result, err := cart.convertToDisplayCurrency(nil, domain.NewFromFloat(10, "USD"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "EUR", result.Currency)
+		assert.InDelta(t, 8, result.FloatAmount(), 0.001)
+	})
+
+	t.Run("errors when no FXRates entry and no converter are available", func(t *testing.T) {
+		cart := Cart{DisplayCurrency: "EUR"}
+
+		_, err := cart.convertToDisplayCurrency(nil, domain.NewFromFloat(10, "USD"))
+
+		assert.ErrorIs(t, err, ErrFXRateMissing)
+	})
+
+	t.Run("propagates the converter's error", func(t *testing.T) {
+		cart := Cart{DisplayCurrency: "EUR"}
+		converter := fixedConverter{err: assert.AnError}
+
+		_, err := cart.convertToDisplayCurrency(converter, domain.NewFromFloat(10, "USD"))
+
+		assert.Error(t, err)
+	})
+}
+
+func TestCart_ValidateCurrencyConsistency(t *testing.T) {
+	t.Run("is a no-op when DisplayCurrency is unset", func(t *testing.T) {
+		cart := Cart{
+			Deliveries: []Delivery{{Cartitems: []Item{{SourcePrice: domain.NewFromFloat(10, "USD")}}}},
+		}
+
+		assert.NoError(t, cart.ValidateCurrencyConsistency())
+	})
+
+	t.Run("errors when an item's source currency has no FXRates entry", func(t *testing.T) {
+		cart := Cart{
+			DisplayCurrency: "EUR",
+			Deliveries:      []Delivery{{Cartitems: []Item{{SourcePrice: domain.NewFromFloat(10, "USD")}}}},
+		}
+
+		err := cart.ValidateCurrencyConsistency()
+
+		assert.ErrorIs(t, err, ErrFXRateMissing)
+	})
+
+	t.Run("errors when the matching FXRates entry is stale", func(t *testing.T) {
+		cart := Cart{
+			DisplayCurrency: "EUR",
+			FXRates:         map[string]domain.Rate{"USD": {Rate: 0.9, AsOf: time.Now().Add(-2 * FXRateMaxAge)}},
+			Deliveries:      []Delivery{{Cartitems: []Item{{SourcePrice: domain.NewFromFloat(10, "USD")}}}},
+		}
+
+		err := cart.ValidateCurrencyConsistency()
+
+		assert.ErrorIs(t, err, ErrFXRateStale)
+	})
+
+	t.Run("passes when the matching FXRates entry is fresh", func(t *testing.T) {
+		cart := Cart{
+			DisplayCurrency: "EUR",
+			FXRates:         map[string]domain.Rate{"USD": {Rate: 0.9, AsOf: time.Now()}},
+			Deliveries:      []Delivery{{Cartitems: []Item{{SourcePrice: domain.NewFromFloat(10, "USD")}}}},
+		}
+
+		assert.NoError(t, cart.ValidateCurrencyConsistency())
+	})
+}
@@ -0,0 +1,94 @@
+package einvoice
+
+import "encoding/xml"
+
+// The types below model the subset of the UBL 2.1 Invoice schema required for an EN16931 compliant
+// Invoice document (cbc = CommonBasicComponents, cac = CommonAggregateComponents). They are kept
+// intentionally minimal - only the elements populated by Marshaller.Marshal are represented.
+type (
+	invoice struct {
+		XMLName  xml.Name `xml:"Invoice"`
+		Xmlns    string   `xml:"xmlns,attr"`
+		XmlnsCbc string   `xml:"xmlns:cbc,attr"`
+		XmlnsCac string   `xml:"xmlns:cac,attr"`
+
+		CustomizationID      string `xml:"cbc:CustomizationID,omitempty"`
+		ProfileID            string `xml:"cbc:ProfileID,omitempty"`
+		ID                   string `xml:"cbc:ID"`
+		IssueDate            string `xml:"cbc:IssueDate"`
+		InvoiceTypeCode      string `xml:"cbc:InvoiceTypeCode"`
+		DocumentCurrencyCode string `xml:"cbc:DocumentCurrencyCode"`
+
+		AccountingSupplierParty party `xml:"cac:AccountingSupplierParty"`
+		AccountingCustomerParty party `xml:"cac:AccountingCustomerParty"`
+
+		TaxTotal    taxTotal      `xml:"cac:TaxTotal"`
+		LegalTotal  legalTotal    `xml:"cac:LegalMonetaryTotal"`
+		InvoiceLine []invoiceLine `xml:"cac:InvoiceLine"`
+	}
+
+	party struct {
+		Party partyDetails `xml:"cac:Party"`
+	}
+
+	partyDetails struct {
+		PartyIdentification string        `xml:"cac:PartyIdentification>cbc:ID,omitempty"`
+		PartyName           string        `xml:"cac:PartyName>cbc:Name,omitempty"`
+		PostalAddress       postalAddress `xml:"cac:PostalAddress"`
+		Contact             contact       `xml:"cac:Contact"`
+	}
+
+	postalAddress struct {
+		StreetName           string `xml:"cbc:StreetName,omitempty"`
+		AdditionalStreetName string `xml:"cbc:AdditionalStreetName,omitempty"`
+		CityName             string `xml:"cbc:CityName,omitempty"`
+		PostalZone           string `xml:"cbc:PostalZone,omitempty"`
+		CountryCode          string `xml:"cac:Country>cbc:IdentificationCode,omitempty"`
+	}
+
+	contact struct {
+		Name           string `xml:"cbc:Name,omitempty"`
+		Telephone      string `xml:"cbc:Telephone,omitempty"`
+		ElectronicMail string `xml:"cbc:ElectronicMail,omitempty"`
+	}
+
+	taxTotal struct {
+		TaxAmount amount `xml:"cbc:TaxAmount"`
+	}
+
+	legalTotal struct {
+		LineExtensionAmount amount `xml:"cbc:LineExtensionAmount"`
+		TaxExclusiveAmount  amount `xml:"cbc:TaxExclusiveAmount"`
+		TaxInclusiveAmount  amount `xml:"cbc:TaxInclusiveAmount"`
+		PayableAmount       amount `xml:"cbc:PayableAmount"`
+	}
+
+	invoiceLine struct {
+		ID                  string            `xml:"cbc:ID"`
+		InvoicedQuantity    int               `xml:"cbc:InvoicedQuantity"`
+		LineExtensionAmount amount            `xml:"cbc:LineExtensionAmount"`
+		AllowanceCharge     []allowanceCharge `xml:"cac:AllowanceCharge,omitempty"`
+		Item                item              `xml:"cac:Item"`
+		Price               price             `xml:"cac:Price"`
+		TaxTotal            taxTotal          `xml:"cac:TaxTotal"`
+	}
+
+	allowanceCharge struct {
+		ChargeIndicator       bool   `xml:"cbc:ChargeIndicator"`
+		AllowanceChargeReason string `xml:"cbc:AllowanceChargeReason,omitempty"`
+		Amount                amount `xml:"cbc:Amount"`
+	}
+
+	item struct {
+		Name string `xml:"cbc:Name"`
+	}
+
+	price struct {
+		PriceAmount amount `xml:"cbc:PriceAmount"`
+	}
+
+	amount struct {
+		CurrencyID string `xml:"currencyID,attr"`
+		Value      string `xml:",chardata"`
+	}
+)
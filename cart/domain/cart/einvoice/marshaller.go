@@ -0,0 +1,182 @@
+// Package einvoice renders a placed order (the Cart snapshot together with its PlacedOrderInfos) into
+// a structured EN16931 compliant UBL 2.1 Invoice XML document.
+package einvoice
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/price/domain"
+	"github.com/pkg/errors"
+)
+
+type (
+	// SupplierInfo holds the merchant / seller details rendered into cac:AccountingSupplierParty. It is
+	// configured once per Marshaller, as this information is store wide and not part of the Cart
+	SupplierInfo struct {
+		Name        string
+		Street      string
+		City        string
+		PostCode    string
+		CountryCode string
+		Email       string
+		Telephone   string
+	}
+
+	// Marshaller renders a Cart and its PlacedOrderInfos into an EN16931 compliant UBL 2.1 Invoice
+	Marshaller struct {
+		supplier SupplierInfo
+		profile  CountryProfile
+	}
+)
+
+// ErrOrderNumberNotFound is returned by Marshal if the given PlacedOrderInfos are empty
+var ErrOrderNumberNotFound = errors.New("no placed order info with an order number given")
+
+// ErrIssueDateNotFound is returned by Marshal if the first PlacedOrderInfo has a zero PlacedAt, since
+// cbc:IssueDate (BT-2) is mandatory for an EN16931 compliant Invoice
+var ErrIssueDateNotFound = errors.New("no placed order info with a PlacedAt given")
+
+// NewMarshaller creates a Marshaller that renders invoices for the given SupplierInfo, customized
+// according to the given CountryProfile (e.g. EN16931Profile for the plain EN16931 base profile)
+func NewMarshaller(supplier SupplierInfo, profile CountryProfile) *Marshaller {
+	return &Marshaller{supplier: supplier, profile: profile}
+}
+
+// Marshal renders the given Cart snapshot and PlacedOrderInfos into an EN16931 compliant UBL 2.1
+// Invoice XML document
+func (m *Marshaller) Marshal(cart cartDomain.Cart, poi cartDomain.PlacedOrderInfos) ([]byte, error) {
+	if len(poi) == 0 {
+		return nil, ErrOrderNumberNotFound
+	}
+
+	if poi[0].PlacedAt.IsZero() {
+		return nil, ErrIssueDateNotFound
+	}
+
+	currency := documentCurrency(cart)
+
+	doc := invoice{
+		Xmlns:    "urn:oasis:names:specification:ubl:schema:xsd:Invoice-2",
+		XmlnsCbc: "urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2",
+		XmlnsCac: "urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2",
+
+		CustomizationID:         m.profile.CustomizationID(),
+		ProfileID:               m.profile.ProfileID(),
+		ID:                      poi[0].OrderNumber,
+		IssueDate:               poi[0].PlacedAt.Format("2006-01-02"),
+		InvoiceTypeCode:         "380",
+		DocumentCurrencyCode:    currency,
+		AccountingSupplierParty: party{Party: m.supplierParty()},
+		AccountingCustomerParty: party{Party: customerParty(cart)},
+		TaxTotal:                taxTotal{TaxAmount: toAmount(cart.CartTotals.TaxAmount, currency)},
+		LegalTotal: legalTotal{
+			LineExtensionAmount: toAmount(cart.CartTotals.SubTotal, currency),
+			TaxExclusiveAmount:  toAmount(cart.CartTotals.SubTotalWithDiscounts, currency),
+			TaxInclusiveAmount:  toAmount(cart.CartTotals.SubTotalWithDiscountsAndTax, currency),
+			PayableAmount:       toAmount(cart.CartTotals.GrandTotal, currency),
+		},
+	}
+
+	for _, delivery := range cart.Deliveries {
+		for _, cartItem := range delivery.Cartitems {
+			doc.InvoiceLine = append(doc.InvoiceLine, invoiceLineFor(cartItem, currency))
+		}
+	}
+
+	out, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return append([]byte(xml.Header), out...), nil
+}
+
+func (m *Marshaller) supplierParty() partyDetails {
+	return partyDetails{
+		PartyName: m.supplier.Name,
+		PostalAddress: postalAddress{
+			StreetName:  m.supplier.Street,
+			CityName:    m.supplier.City,
+			PostalZone:  m.supplier.PostCode,
+			CountryCode: m.supplier.CountryCode,
+		},
+		Contact: contact{
+			Name:           m.supplier.Name,
+			Telephone:      m.supplier.Telephone,
+			ElectronicMail: m.supplier.Email,
+		},
+	}
+}
+
+func customerParty(cart cartDomain.Cart) partyDetails {
+	billing := cart.BillingAdress
+
+	return partyDetails{
+		PartyIdentification: purchaserID(cart.Purchaser),
+		PartyName:           billing.Firstname + " " + billing.Lastname,
+		PostalAddress: postalAddress{
+			StreetName:  billing.Street,
+			CityName:    billing.City,
+			PostalZone:  billing.PostCode,
+			CountryCode: billing.CountryCode,
+		},
+		Contact: contact{
+			Name:           billing.Firstname + " " + billing.Lastname,
+			Telephone:      billing.Telephone,
+			ElectronicMail: billing.Email,
+		},
+	}
+}
+
+// purchaserID returns the buyer identifier (cac:PartyIdentification) to render for purchaser, sourced
+// from ExistingCustomerData.ID when the purchaser is a known existing customer
+func purchaserID(purchaser cartDomain.Person) string {
+	if purchaser.ExistingCustomerData == nil {
+		return ""
+	}
+
+	return purchaser.ExistingCustomerData.ID
+}
+
+func invoiceLineFor(item cartDomain.Item, currency string) invoiceLine {
+	lineExtension, err := item.RowTotalWithDiscountInclTax.Sub(item.TaxAmount)
+	if err != nil {
+		lineExtension = item.RowTotalWithDiscountInclTax
+	}
+
+	line := invoiceLine{
+		ID:                  item.ID,
+		InvoicedQuantity:    item.Qty,
+		LineExtensionAmount: toAmount(lineExtension, currency),
+		Item:                itemFrom(item),
+		Price:               price{PriceAmount: toAmount(item.SinglePrice, currency)},
+		TaxTotal:            taxTotal{TaxAmount: toAmount(item.TaxAmount, currency)},
+	}
+
+	for _, discount := range item.AppliedDiscounts {
+		line.AllowanceCharge = append(line.AllowanceCharge, allowanceCharge{
+			ChargeIndicator:       false,
+			AllowanceChargeReason: discount.Title,
+			Amount:                toAmount(discount.Price, currency),
+		})
+	}
+
+	return line
+}
+
+func itemFrom(cartItem cartDomain.Item) item {
+	return item{Name: cartItem.ProductName}
+}
+
+func toAmount(p domain.Price, currency string) amount {
+	return amount{CurrencyID: currency, Value: fmt.Sprintf("%.2f", p.FloatAmount())}
+}
+
+// documentCurrency derives the DocumentCurrencyCode for the invoice from the cart's GrandTotal. Carts
+// that mix source currencies per line (see Cart.DisplayCurrency) are expected to have normalized
+// CartTotals into a single display currency already
+func documentCurrency(cart cartDomain.Cart) string {
+	return cart.CartTotals.GrandTotal.Currency
+}
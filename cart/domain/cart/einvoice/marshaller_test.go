@@ -0,0 +1,46 @@
+package einvoice
+
+import (
+	"testing"
+	"time"
+
+	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/price/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshaller_Marshal(t *testing.T) {
+	supplier := SupplierInfo{Name: "Acme Inc", Street: "Main St 1", City: "Berlin", PostCode: "10115", CountryCode: "DE"}
+	m := NewMarshaller(supplier, EN16931Profile{})
+
+	cart := cartDomain.Cart{
+		BillingAdress: cartDomain.Address{Firstname: "Jane", Lastname: "Doe", Street: "Side St 2", City: "Munich", PostCode: "80331", CountryCode: "DE"},
+		Purchaser: cartDomain.Person{
+			ExistingCustomerData: &cartDomain.ExistingCustomerData{ID: "customer-42"},
+		},
+		CartTotals: cartDomain.Totals{GrandTotal: domain.NewFromFloat(100, "EUR")},
+	}
+	poi := cartDomain.PlacedOrderInfos{{OrderNumber: "ORDER-1", PlacedAt: time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC)}}
+
+	t.Run("renders the mandatory fields and party identification", func(t *testing.T) {
+		out, err := m.Marshal(cart, poi)
+
+		assert.NoError(t, err)
+		assert.Contains(t, string(out), "<cbc:ID>ORDER-1</cbc:ID>")
+		assert.Contains(t, string(out), "<cbc:IssueDate>2026-07-01</cbc:IssueDate>")
+		assert.Contains(t, string(out), "<cac:PartyIdentification>")
+		assert.Contains(t, string(out), "<cbc:ID>customer-42</cbc:ID>")
+	})
+
+	t.Run("errors when no PlacedOrderInfo is given", func(t *testing.T) {
+		_, err := m.Marshal(cart, nil)
+
+		assert.ErrorIs(t, err, ErrOrderNumberNotFound)
+	})
+
+	t.Run("errors when the PlacedOrderInfo has no PlacedAt", func(t *testing.T) {
+		_, err := m.Marshal(cart, cartDomain.PlacedOrderInfos{{OrderNumber: "ORDER-1"}})
+
+		assert.ErrorIs(t, err, ErrIssueDateNotFound)
+	})
+}
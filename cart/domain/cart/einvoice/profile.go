@@ -0,0 +1,28 @@
+package einvoice
+
+// CountryProfile supplies the customization and profile identifiers that classify the rendered
+// Invoice document for a particular e-invoicing specialization (e.g. Peppol BIS Billing 3.0, the
+// Romanian e-Factura, or the German XRechnung). Projects implement this interface to customize the
+// document without forking Marshaller
+type CountryProfile interface {
+	// CustomizationID identifies the specification the Invoice complies with, rendered as cbc:CustomizationID
+	CustomizationID() string
+	// ProfileID identifies the business process the Invoice is used in, rendered as cbc:ProfileID
+	ProfileID() string
+}
+
+// EN16931Profile is the default CountryProfile, identifying the document as a plain EN16931
+// compliant UBL 2.1 Invoice with no additional country specific customization
+type EN16931Profile struct{}
+
+var _ CountryProfile = EN16931Profile{}
+
+// CustomizationID returns the generic EN16931 customization identifier
+func (EN16931Profile) CustomizationID() string {
+	return "urn:cen.eu:en16931:2017"
+}
+
+// ProfileID returns the Peppol billing business process identifier (billing:01:1.0)
+func (EN16931Profile) ProfileID() string {
+	return "urn:fdc:peppol.eu:2017:poacc:billing:01:1.0"
+}
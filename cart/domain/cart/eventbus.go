@@ -0,0 +1,58 @@
+package cart
+
+import (
+	"context"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/web"
+)
+
+type (
+	// CartChangedEvent value object signals that a Cart was modified, so that other nodes in a
+	// horizontally-scaled deployment can decide whether their own view of the cart is stale
+	CartChangedEvent struct {
+		CartID    string
+		EntityID  string
+		Version   int
+		ChangedAt time.Time
+	}
+
+	// OrderPlacedEvent value object signals that a Cart was successfully placed as an order
+	OrderPlacedEvent struct {
+		OrderNumber string
+		CartID      string
+	}
+
+	// CartInvalidationMessage is the wire-safe counterpart of InvalidateCartEvent. InvalidateCartEvent
+	// itself carries a *web.Session which is only meaningful on the node that created it, so a
+	// CartEventPublisher publishes this message instead and a receiving node resolves its own local
+	// session via an injected SessionResolver
+	CartInvalidationMessage struct {
+		CartID              string
+		AuthenticatedUserID string
+	}
+
+	// CartEventPublisher is a secondary port for broadcasting cart events to every node of a
+	// horizontally-scaled deployment, so that a cart update on one node can invalidate caches held by
+	// the others
+	CartEventPublisher interface {
+		PublishCartInvalidation(ctx context.Context, message CartInvalidationMessage) error
+		PublishCartChanged(ctx context.Context, event CartChangedEvent) error
+		PublishOrderPlaced(ctx context.Context, event OrderPlacedEvent) error
+	}
+
+	// CartEventSubscriber is a secondary port that receives cart events published by CartEventPublisher
+	// on other nodes and applies their local side effect (e.g. purging a session cart cache)
+	CartEventSubscriber interface {
+		// Subscribe starts processing events until the given context is cancelled or Close is called
+		Subscribe(ctx context.Context) error
+		// Close stops processing events and releases the underlying connection/consumer
+		Close() error
+	}
+
+	// SessionResolver resolves the local web.Session belonging to a cart, so that a CartEventSubscriber
+	// can translate a foreign-node CartInvalidationMessage into a local session cache purge
+	SessionResolver interface {
+		Resolve(ctx context.Context, cartID string, authenticatedUserID string) (*web.Session, error)
+	}
+)
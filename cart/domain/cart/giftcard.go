@@ -0,0 +1,168 @@
+package cart
+
+import (
+	"context"
+
+	"flamingo.me/flamingo-commerce/v3/price/domain"
+	"github.com/pkg/errors"
+)
+
+type (
+	// AppliedGiftCard value object represents a gift card that has been (partially) redeemed against a Cart
+	AppliedGiftCard struct {
+		//Code - the gift card code as entered by the customer
+		Code string
+		//Applied - the amount of this gift card that is currently applied to the cart
+		Applied domain.Price
+		//Remaining - the amount still available on this gift card after the Applied amount is redeemed
+		Remaining domain.Price
+	}
+
+	// GiftCardAndVoucherBehaviour is a cart behaviour port for applying and removing both voucher codes
+	// and gift card codes
+	GiftCardAndVoucherBehaviour interface {
+		ApplyVoucher(ctx context.Context, cart *Cart, couponCode string) (*Cart, DeferEvents, error)
+		RemoveVoucher(ctx context.Context, cart *Cart, couponCode string) (*Cart, DeferEvents, error)
+		ApplyGiftCard(ctx context.Context, cart *Cart, giftCardCode string) (*Cart, DeferEvents, error)
+		RemoveGiftCard(ctx context.Context, cart *Cart, giftCardCode string) (*Cart, DeferEvents, error)
+		// ApplyAny applies the given code as either a voucher or a gift card. If the code is identified
+		// as belonging to the other type it returns ErrCodeIsGiftCard / ErrCodeIsVoucher instead of
+		// applying it, so the frontend can route the code to the matching dedicated method
+		ApplyAny(ctx context.Context, cart *Cart, anyCode string) (*Cart, DeferEvents, error)
+	}
+)
+
+var (
+	// ErrCodeIsGiftCard is returned by ApplyAny/ApplyVoucher when the given code is identified as a
+	// gift card code instead of a voucher code
+	ErrCodeIsGiftCard = errors.New("code is a gift card code")
+
+	// ErrCodeIsVoucher is returned by ApplyAny/ApplyGiftCard when the given code is identified as a
+	// voucher code instead of a gift card code
+	ErrCodeIsVoucher = errors.New("code is a voucher code")
+
+	// ErrGiftCardNotFound is returned by RemoveGiftCard when no AppliedGiftCard matches the given code
+	ErrGiftCardNotFound = errors.New("gift card not found")
+)
+
+// HasAppliedGiftCards checks if at least one gift card is applied to the cart
+func (Cart Cart) HasAppliedGiftCards() bool {
+	return len(Cart.AppliedGiftCards) > 0
+}
+
+// ApplyGiftCard appends giftCard to AppliedGiftCards and syncs the matching TotalsTypeGiftcard entry in
+// CartTotals.Totalitems, so that code enumerating Totalitems (the established pattern for vouchers and
+// discounts) also surfaces gift card lines
+func (Cart *Cart) ApplyGiftCard(giftCard AppliedGiftCard) {
+	Cart.AppliedGiftCards = append(Cart.AppliedGiftCards, giftCard)
+	Cart.syncGiftCardTotalitems()
+}
+
+// RemoveGiftCard removes the AppliedGiftCard with the given code and syncs CartTotals.Totalitems
+func (Cart *Cart) RemoveGiftCard(code string) error {
+	for i, giftCard := range Cart.AppliedGiftCards {
+		if giftCard.Code != code {
+			continue
+		}
+
+		Cart.AppliedGiftCards = append(Cart.AppliedGiftCards[:i], Cart.AppliedGiftCards[i+1:]...)
+		Cart.syncGiftCardTotalitems()
+
+		return nil
+	}
+
+	return errors.Wrapf(ErrGiftCardNotFound, "code %q", code)
+}
+
+// syncGiftCardTotalitems rebuilds the TotalsTypeGiftcard entries in CartTotals.Totalitems from the
+// current AppliedGiftCards, replacing whatever entries of that type were there before
+func (Cart *Cart) syncGiftCardTotalitems() {
+	kept := make([]Totalitem, 0, len(Cart.CartTotals.Totalitems)+len(Cart.AppliedGiftCards))
+	for _, item := range Cart.CartTotals.Totalitems {
+		if item.Type != TotalsTypeGiftcard {
+			kept = append(kept, item)
+		}
+	}
+
+	for _, giftCard := range Cart.AppliedGiftCards {
+		kept = append(kept, Totalitem{
+			Code:  giftCard.Code,
+			Title: giftCard.Code,
+			Price: giftCard.Applied,
+			Type:  TotalsTypeGiftcard,
+		})
+	}
+
+	Cart.CartTotals.Totalitems = kept
+}
+
+// GetGiftCardSavings returns the sum of all CartTotals.Totalitems of TotalsTypeGiftcard, mirroring
+// GetVoucherSavings/GetSavings for the other Totalitem types
+func (Cart Cart) GetGiftCardSavings() domain.Price {
+	price := domain.Price{}
+	for _, item := range Cart.CartTotals.Totalitems {
+		if item.Type == TotalsTypeGiftcard {
+			newPrice, err := price.Add(item.Price)
+			if err != nil {
+				return price
+			}
+			price = newPrice
+		}
+	}
+
+	if price.IsNegative() {
+		return domain.Price{}
+	}
+
+	return price
+}
+
+// GetTotalGiftCardAmount returns the sum of the Applied amount of all AppliedGiftCards, converted into
+// Cart.DisplayCurrency via converter so that gift cards issued in a different currency can still be
+// mixed in (converter may be nil, see Cart.convertToDisplayCurrency). It fails rather than silently
+// under-crediting a gift card that can't be converted or summed
+func (Cart Cart) GetTotalGiftCardAmount(converter CurrencyConverter) (domain.Price, error) {
+	price := domain.Price{}
+	for _, giftCard := range Cart.AppliedGiftCards {
+		applied, err := Cart.convertToDisplayCurrency(converter, giftCard.Applied)
+		if err != nil {
+			return domain.Price{}, errors.Wrapf(err, "converting gift card %q", giftCard.Code)
+		}
+
+		newPrice, err := price.Add(applied)
+		if err != nil {
+			return domain.Price{}, errors.Wrapf(err, "summing gift card %q", giftCard.Code)
+		}
+		price = newPrice
+	}
+
+	return price, nil
+}
+
+// GrandTotalNetOfGiftCards returns CartTotals.GrandTotal reduced by the total applied gift card amount.
+// Unlike coupons, gift cards are a payment reducer and not a discount - they must not shrink the
+// taxable base that CartTotals.GrandTotal represents, which is why this is a separate helper instead of
+// being folded into GrandTotal itself
+func (Cart Cart) GrandTotalNetOfGiftCards(converter CurrencyConverter) (domain.Price, error) {
+	giftCardAmount, err := Cart.GetTotalGiftCardAmount(converter)
+	if err != nil {
+		return domain.Price{}, err
+	}
+
+	net, err := Cart.CartTotals.GrandTotal.Sub(giftCardAmount)
+	if err != nil {
+		return domain.Price{}, errors.Wrap(err, "subtracting gift card amount from grand total")
+	}
+
+	if net.IsNegative() {
+		return domain.Price{}, nil
+	}
+
+	return net, nil
+}
+
+// RemainingAmountToPay returns the amount still due for this cart after applied gift cards, i.e. the
+// amount that needs to be collected via the selected payment method
+func (Cart Cart) RemainingAmountToPay(converter CurrencyConverter) (domain.Price, error) {
+	return Cart.GrandTotalNetOfGiftCards(converter)
+}
@@ -0,0 +1,104 @@
+package cart
+
+import (
+	"testing"
+
+	"flamingo.me/flamingo-commerce/v3/price/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCart_ApplyGiftCard(t *testing.T) {
+	cart := &Cart{}
+
+	cart.ApplyGiftCard(AppliedGiftCard{Code: "GC-1", Applied: domain.NewFromFloat(5, "EUR")})
+
+	assert.True(t, cart.HasAppliedGiftCards())
+	assert.Len(t, cart.CartTotals.Totalitems, 1)
+	assert.Equal(t, TotalsTypeGiftcard, cart.CartTotals.Totalitems[0].Type)
+	assert.Equal(t, "GC-1", cart.CartTotals.Totalitems[0].Code)
+}
+
+func TestCart_RemoveGiftCard(t *testing.T) {
+	t.Run("removes the applied gift card and its Totalitem", func(t *testing.T) {
+		cart := &Cart{}
+		cart.ApplyGiftCard(AppliedGiftCard{Code: "GC-1", Applied: domain.NewFromFloat(5, "EUR")})
+		cart.ApplyGiftCard(AppliedGiftCard{Code: "GC-2", Applied: domain.NewFromFloat(3, "EUR")})
+
+		err := cart.RemoveGiftCard("GC-1")
+
+		assert.NoError(t, err)
+		assert.Len(t, cart.AppliedGiftCards, 1)
+		assert.Len(t, cart.CartTotals.Totalitems, 1)
+		assert.Equal(t, "GC-2", cart.CartTotals.Totalitems[0].Code)
+	})
+
+	t.Run("errors when the code is not applied", func(t *testing.T) {
+		cart := &Cart{}
+
+		err := cart.RemoveGiftCard("missing")
+
+		assert.ErrorIs(t, err, ErrGiftCardNotFound)
+	})
+}
+
+func TestCart_GetGiftCardSavings(t *testing.T) {
+	cart := &Cart{}
+	cart.ApplyGiftCard(AppliedGiftCard{Code: "GC-1", Applied: domain.NewFromFloat(5, "EUR")})
+	cart.ApplyGiftCard(AppliedGiftCard{Code: "GC-2", Applied: domain.NewFromFloat(3, "EUR")})
+
+	savings := cart.GetGiftCardSavings()
+
+	assert.InDelta(t, 8, savings.FloatAmount(), 0.001)
+}
+
+func TestCart_GetTotalGiftCardAmount(t *testing.T) {
+	t.Run("sums applied gift cards already in DisplayCurrency", func(t *testing.T) {
+		cart := Cart{
+			DisplayCurrency:  "EUR",
+			AppliedGiftCards: []AppliedGiftCard{{Code: "GC-1", Applied: domain.NewFromFloat(5, "EUR")}},
+		}
+
+		total, err := cart.GetTotalGiftCardAmount(nil)
+
+		assert.NoError(t, err)
+		assert.InDelta(t, 5, total.FloatAmount(), 0.001)
+	})
+
+	t.Run("errors instead of under-crediting when a gift card can't be converted", func(t *testing.T) {
+		cart := Cart{
+			DisplayCurrency:  "EUR",
+			AppliedGiftCards: []AppliedGiftCard{{Code: "GC-1", Applied: domain.NewFromFloat(5, "USD")}},
+		}
+
+		_, err := cart.GetTotalGiftCardAmount(nil)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestCart_GrandTotalNetOfGiftCards(t *testing.T) {
+	t.Run("errors instead of returning the un-reduced GrandTotal when Sub fails", func(t *testing.T) {
+		cart := Cart{
+			CartTotals:       Totals{GrandTotal: domain.NewFromFloat(20, "EUR")},
+			DisplayCurrency:  "USD",
+			AppliedGiftCards: []AppliedGiftCard{{Code: "GC-1", Applied: domain.NewFromFloat(5, "USD")}},
+		}
+
+		_, err := cart.GrandTotalNetOfGiftCards(nil)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestCart_RemainingAmountToPay(t *testing.T) {
+	cart := Cart{
+		CartTotals:       Totals{GrandTotal: domain.NewFromFloat(20, "EUR")},
+		DisplayCurrency:  "EUR",
+		AppliedGiftCards: []AppliedGiftCard{{Code: "GC-1", Applied: domain.NewFromFloat(5, "EUR")}},
+	}
+
+	remaining, err := cart.RemainingAmountToPay(nil)
+
+	assert.NoError(t, err)
+	assert.InDelta(t, 15, remaining.FloatAmount(), 0.001)
+}
@@ -46,3 +46,131 @@ func (_m *GiftCardAndVoucherBehaviour) ApplyAny(ctx context.Context, _a1 *cart.C
 
 	return r0, r1, r2
 }
+
+// ApplyGiftCard provides a mock function with given fields: ctx, _a1, giftCardCode
+func (_m *GiftCardAndVoucherBehaviour) ApplyGiftCard(ctx context.Context, _a1 *cart.Cart, giftCardCode string) (*cart.Cart, cart.DeferEvents, error) {
+	ret := _m.Called(ctx, _a1, giftCardCode)
+
+	var r0 *cart.Cart
+	if rf, ok := ret.Get(0).(func(context.Context, *cart.Cart, string) *cart.Cart); ok {
+		r0 = rf(ctx, _a1, giftCardCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cart.Cart)
+		}
+	}
+
+	var r1 cart.DeferEvents
+	if rf, ok := ret.Get(1).(func(context.Context, *cart.Cart, string) cart.DeferEvents); ok {
+		r1 = rf(ctx, _a1, giftCardCode)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(cart.DeferEvents)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *cart.Cart, string) error); ok {
+		r2 = rf(ctx, _a1, giftCardCode)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RemoveGiftCard provides a mock function with given fields: ctx, _a1, giftCardCode
+func (_m *GiftCardAndVoucherBehaviour) RemoveGiftCard(ctx context.Context, _a1 *cart.Cart, giftCardCode string) (*cart.Cart, cart.DeferEvents, error) {
+	ret := _m.Called(ctx, _a1, giftCardCode)
+
+	var r0 *cart.Cart
+	if rf, ok := ret.Get(0).(func(context.Context, *cart.Cart, string) *cart.Cart); ok {
+		r0 = rf(ctx, _a1, giftCardCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cart.Cart)
+		}
+	}
+
+	var r1 cart.DeferEvents
+	if rf, ok := ret.Get(1).(func(context.Context, *cart.Cart, string) cart.DeferEvents); ok {
+		r1 = rf(ctx, _a1, giftCardCode)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(cart.DeferEvents)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *cart.Cart, string) error); ok {
+		r2 = rf(ctx, _a1, giftCardCode)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ApplyVoucher provides a mock function with given fields: ctx, _a1, couponCode
+func (_m *GiftCardAndVoucherBehaviour) ApplyVoucher(ctx context.Context, _a1 *cart.Cart, couponCode string) (*cart.Cart, cart.DeferEvents, error) {
+	ret := _m.Called(ctx, _a1, couponCode)
+
+	var r0 *cart.Cart
+	if rf, ok := ret.Get(0).(func(context.Context, *cart.Cart, string) *cart.Cart); ok {
+		r0 = rf(ctx, _a1, couponCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cart.Cart)
+		}
+	}
+
+	var r1 cart.DeferEvents
+	if rf, ok := ret.Get(1).(func(context.Context, *cart.Cart, string) cart.DeferEvents); ok {
+		r1 = rf(ctx, _a1, couponCode)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(cart.DeferEvents)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *cart.Cart, string) error); ok {
+		r2 = rf(ctx, _a1, couponCode)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RemoveVoucher provides a mock function with given fields: ctx, _a1, couponCode
+func (_m *GiftCardAndVoucherBehaviour) RemoveVoucher(ctx context.Context, _a1 *cart.Cart, couponCode string) (*cart.Cart, cart.DeferEvents, error) {
+	ret := _m.Called(ctx, _a1, couponCode)
+
+	var r0 *cart.Cart
+	if rf, ok := ret.Get(0).(func(context.Context, *cart.Cart, string) *cart.Cart); ok {
+		r0 = rf(ctx, _a1, couponCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cart.Cart)
+		}
+	}
+
+	var r1 cart.DeferEvents
+	if rf, ok := ret.Get(1).(func(context.Context, *cart.Cart, string) cart.DeferEvents); ok {
+		r1 = rf(ctx, _a1, couponCode)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(cart.DeferEvents)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *cart.Cart, string) error); ok {
+		r2 = rf(ctx, _a1, couponCode)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
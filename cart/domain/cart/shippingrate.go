@@ -0,0 +1,100 @@
+package cart
+
+import (
+	"context"
+
+	"flamingo.me/flamingo-commerce/v3/price/domain"
+	"github.com/pkg/errors"
+)
+
+type (
+	// ShippingRateQuote represents a single carrier rate option for a Delivery, as returned by a
+	// ShippingRateProvider, that can later be selected via Cart.UpdateDeliveryRate
+	ShippingRateQuote struct {
+		//Carrier - the name of the carrier offering this quote (e.g. "UPS", "USPS", "DHL")
+		Carrier string
+		//ServiceLevel - the carrier specific service level (e.g. "Ground", "2Day", "Overnight")
+		ServiceLevel string
+		//Price - the price of this shipping option
+		Price domain.Price
+		//Tax - the tax amount included in Price, applied to ShippingItem.TaxAmount when this quote is selected
+		Tax domain.Price
+		//EstimatedDeliveryDays - the estimated amount of days until delivery
+		EstimatedDeliveryDays int
+		//Token - opaque identifier used to select this quote via Cart.UpdateDeliveryRate
+		Token string
+	}
+
+	// ShippingRateProvider is a secondary port that rate-shops a Delivery (its DeliveryLocation and
+	// Cartitems) across one or more carriers and returns the resulting ShippingRateQuotes
+	ShippingRateProvider interface {
+		GetRates(ctx context.Context, delivery Delivery) ([]ShippingRateQuote, error)
+	}
+)
+
+// ErrShippingRateQuoteNotFound is returned when the given token does not match any AvailableRates
+var ErrShippingRateQuoteNotFound = errors.New("shipping rate quote not found")
+
+// UpdateDeliveryRate resolves the ShippingRateQuote identified by token on the delivery with the given
+// deliveryCode, applies it to that delivery's ShippingItem and recomputes CartTotals.TotalShippingItem.
+// converter is used to express the result in Cart.DisplayCurrency when quote.Price was sourced in a
+// different currency - it may be nil for carts that don't set DisplayCurrency
+func (Cart *Cart) UpdateDeliveryRate(converter CurrencyConverter, deliveryCode string, token string) error {
+	for i, delivery := range Cart.Deliveries {
+		if delivery.DeliveryInfo.Code != deliveryCode {
+			continue
+		}
+
+		quote, found := delivery.GetAvailableRateByToken(token)
+		if !found {
+			return ErrShippingRateQuoteNotFound
+		}
+
+		Cart.Deliveries[i].SelectedRateToken = token
+		Cart.Deliveries[i].ShippingItem.Title = quote.Carrier + " " + quote.ServiceLevel
+		Cart.Deliveries[i].ShippingItem.Price = quote.Price
+		Cart.Deliveries[i].ShippingItem.TaxAmount = quote.Tax
+
+		// DeliveryTotals intentionally isn't touched here - it has no shipping-cost field, shipping is
+		// only ever reflected in CartTotals.TotalShippingItem
+		return Cart.recalculateTotalShippingItem(converter)
+	}
+
+	return errors.Errorf("delivery for code %v not found", deliveryCode)
+}
+
+// GetAvailableRateByToken returns the AvailableRates entry matching the given token
+func (d Delivery) GetAvailableRateByToken(token string) (ShippingRateQuote, bool) {
+	for _, quote := range d.AvailableRates {
+		if quote.Token == token {
+			return quote, true
+		}
+	}
+
+	return ShippingRateQuote{}, false
+}
+
+// recalculateTotalShippingItem sums the ShippingItem price of every delivery into CartTotals.TotalShippingItem,
+// converting each one into Cart.DisplayCurrency first so that mixing currencies across deliveries is legal.
+// It fails rather than silently under-totalling shipping costs if a delivery's price can't be converted
+// or summed
+func (Cart *Cart) recalculateTotalShippingItem(converter CurrencyConverter) error {
+	total := domain.Price{}
+
+	for _, delivery := range Cart.Deliveries {
+		price, err := Cart.convertToDisplayCurrency(converter, delivery.ShippingItem.Price)
+		if err != nil {
+			return errors.Wrapf(err, "converting shipping item for delivery %q", delivery.DeliveryInfo.Code)
+		}
+
+		newTotal, err := total.Add(price)
+		if err != nil {
+			return errors.Wrapf(err, "summing shipping item for delivery %q", delivery.DeliveryInfo.Code)
+		}
+		total = newTotal
+	}
+
+	Cart.CartTotals.TotalShippingItem.Price = total
+
+	return nil
+}
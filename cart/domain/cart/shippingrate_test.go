@@ -0,0 +1,91 @@
+package cart
+
+import (
+	"testing"
+
+	"flamingo.me/flamingo-commerce/v3/price/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelivery_GetAvailableRateByToken(t *testing.T) {
+	delivery := Delivery{AvailableRates: []ShippingRateQuote{{Token: "rate-1", Carrier: "UPS"}}}
+
+	t.Run("returns the matching quote", func(t *testing.T) {
+		quote, found := delivery.GetAvailableRateByToken("rate-1")
+
+		assert.True(t, found)
+		assert.Equal(t, "UPS", quote.Carrier)
+	})
+
+	t.Run("reports not found for an unknown token", func(t *testing.T) {
+		_, found := delivery.GetAvailableRateByToken("missing")
+
+		assert.False(t, found)
+	})
+}
+
+func TestCart_UpdateDeliveryRate(t *testing.T) {
+	t.Run("applies the selected quote and recalculates TotalShippingItem", func(t *testing.T) {
+		cart := &Cart{
+			Deliveries: []Delivery{{
+				DeliveryInfo: DeliveryInfo{Code: "delivery-1"},
+				AvailableRates: []ShippingRateQuote{
+					{Token: "rate-1", Carrier: "UPS", ServiceLevel: "Ground", Price: domain.NewFromFloat(5, "EUR"), Tax: domain.NewFromFloat(1, "EUR")},
+				},
+			}},
+		}
+
+		err := cart.UpdateDeliveryRate(nil, "delivery-1", "rate-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "rate-1", cart.Deliveries[0].SelectedRateToken)
+		assert.Equal(t, "UPS Ground", cart.Deliveries[0].ShippingItem.Title)
+		assert.InDelta(t, 1, cart.Deliveries[0].ShippingItem.TaxAmount.FloatAmount(), 0.001)
+		assert.InDelta(t, 5, cart.CartTotals.TotalShippingItem.Price.FloatAmount(), 0.001)
+	})
+
+	t.Run("errors when the delivery code is unknown", func(t *testing.T) {
+		cart := &Cart{}
+
+		err := cart.UpdateDeliveryRate(nil, "missing", "rate-1")
+
+		assert.Error(t, err)
+	})
+
+	t.Run("errors when the token doesn't match an available rate", func(t *testing.T) {
+		cart := &Cart{Deliveries: []Delivery{{DeliveryInfo: DeliveryInfo{Code: "delivery-1"}}}}
+
+		err := cart.UpdateDeliveryRate(nil, "delivery-1", "missing")
+
+		assert.ErrorIs(t, err, ErrShippingRateQuoteNotFound)
+	})
+}
+
+func TestCart_recalculateTotalShippingItem(t *testing.T) {
+	t.Run("sums every delivery's ShippingItem price", func(t *testing.T) {
+		cart := &Cart{
+			Deliveries: []Delivery{
+				{ShippingItem: ShippingItem{Price: domain.NewFromFloat(5, "EUR")}},
+				{ShippingItem: ShippingItem{Price: domain.NewFromFloat(3, "EUR")}},
+			},
+		}
+
+		err := cart.recalculateTotalShippingItem(nil)
+
+		assert.NoError(t, err)
+		assert.InDelta(t, 8, cart.CartTotals.TotalShippingItem.Price.FloatAmount(), 0.001)
+	})
+
+	t.Run("errors instead of under-totalling when a delivery's price can't be converted", func(t *testing.T) {
+		cart := &Cart{
+			DisplayCurrency: "EUR",
+			Deliveries: []Delivery{
+				{DeliveryInfo: DeliveryInfo{Code: "delivery-1"}, ShippingItem: ShippingItem{Price: domain.NewFromFloat(5, "USD")}},
+			},
+		}
+
+		err := cart.recalculateTotalShippingItem(nil)
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,48 @@
+// Package memory provides a fixed-rate CurrencyConverter implementation - useful for local development
+// and tests before a live rate feed (e.g. an ECB or OpenExchangeRates backed adapter) is wired up
+package memory
+
+import (
+	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/price/domain"
+	"github.com/pkg/errors"
+)
+
+type (
+	// Converter is an in-memory cartDomain.CurrencyConverter that converts using a fixed set of
+	// source currency to target currency rates configured at construction time
+	Converter struct {
+		rates map[string]map[string]float64
+	}
+)
+
+var _ cartDomain.CurrencyConverter = new(Converter)
+
+// ErrRateNotConfigured is returned by Convert when no rate is configured for the requested source/target pair
+var ErrRateNotConfigured = errors.New("no fixed rate configured for currency pair")
+
+// NewConverter creates a new Converter from the given rates, keyed by source currency and then by
+// target currency, e.g. rates["USD"]["EUR"] = 0.92
+func NewConverter(rates map[string]map[string]float64) *Converter {
+	return &Converter{rates: rates}
+}
+
+// Convert returns price expressed in target, using the fixed rate configured for price.Currency -> target.
+// A price already in target is returned unchanged
+func (c *Converter) Convert(price domain.Price, target string) (domain.Price, error) {
+	if price.Currency == target {
+		return price, nil
+	}
+
+	targetRates, ok := c.rates[price.Currency]
+	if !ok {
+		return domain.Price{}, errors.Wrapf(ErrRateNotConfigured, "%q -> %q", price.Currency, target)
+	}
+
+	rate, ok := targetRates[target]
+	if !ok {
+		return domain.Price{}, errors.Wrapf(ErrRateNotConfigured, "%q -> %q", price.Currency, target)
+	}
+
+	return domain.NewFromFloat(price.FloatAmount()*rate, target), nil
+}
@@ -0,0 +1,72 @@
+// Package nats provides a CartEventPublisher / CartEventSubscriber adapter that broadcasts cart
+// events across a horizontally-scaled deployment via NATS JetStream, so that a cart update handled by
+// one node is reflected in the session cache held by every other node.
+package nats
+
+import (
+	"context"
+	"encoding/json"
+
+	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"github.com/nats-io/nats.go"
+	"github.com/pkg/errors"
+)
+
+type (
+	// Subjects configures the NATS subjects that cart events are published to / consumed from
+	Subjects struct {
+		// Invalidate is the subject CartInvalidationMessages are published to, e.g. "commerce.cart.invalidate"
+		Invalidate string
+		// Changed is the subject CartChangedEvents are published to, e.g. "commerce.cart.changed"
+		Changed string
+		// OrderPlaced is the subject OrderPlacedEvents are published to, e.g. "commerce.cart.order_placed"
+		OrderPlaced string
+	}
+
+	// Publisher is a cartDomain.CartEventPublisher that publishes cart events onto configurable NATS
+	// JetStream subjects so that every other node in the deployment can react to them
+	Publisher struct {
+		js       nats.JetStreamContext
+		subjects Subjects
+	}
+)
+
+var _ cartDomain.CartEventPublisher = new(Publisher)
+
+// NewPublisher creates a new Publisher that publishes onto the given JetStreamContext, using the
+// given Subjects
+func NewPublisher(js nats.JetStreamContext, subjects Subjects) *Publisher {
+	return &Publisher{js: js, subjects: subjects}
+}
+
+// PublishCartInvalidation publishes a CartInvalidationMessage onto Subjects.Invalidate, so that the
+// node owning the session for the given cart can purge its local cache entry
+func (p *Publisher) PublishCartInvalidation(ctx context.Context, message cartDomain.CartInvalidationMessage) error {
+	return p.publish(ctx, p.subjects.Invalidate, message)
+}
+
+// PublishCartChanged publishes a CartChangedEvent onto Subjects.Changed
+func (p *Publisher) PublishCartChanged(ctx context.Context, event cartDomain.CartChangedEvent) error {
+	return p.publish(ctx, p.subjects.Changed, event)
+}
+
+// PublishOrderPlaced publishes an OrderPlacedEvent onto Subjects.OrderPlaced
+func (p *Publisher) PublishOrderPlaced(ctx context.Context, event cartDomain.OrderPlacedEvent) error {
+	return p.publish(ctx, p.subjects.OrderPlaced, event)
+}
+
+// publish marshals the given payload to JSON and publishes it to JetStream, acknowledging only once
+// the message has been durably persisted by the stream
+func (p *Publisher) publish(ctx context.Context, subject string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrapf(err, "marshalling payload for subject %q", subject)
+	}
+
+	_, err = p.js.Publish(subject, data, nats.Context(ctx))
+	if err != nil {
+		return errors.Wrapf(err, "publishing to subject %q", subject)
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"github.com/nats-io/nats.go"
+	"github.com/pkg/errors"
+)
+
+// sessionCartCacheKey is the session key the in-process cart cache stores the last known Cart under.
+// Deleting it forces the next request on this node to refetch the Cart instead of serving a stale copy
+const sessionCartCacheKey = "cart.cache"
+
+type (
+	// DurableConsumers configures the JetStream durable consumer name used per subject, so that a
+	// briefly disconnected node resumes from where it left off instead of missing invalidations
+	DurableConsumers struct {
+		// Invalidate is the durable consumer name for Subjects.Invalidate
+		Invalidate string
+		// Changed is the durable consumer name for Subjects.Changed
+		Changed string
+		// OrderPlaced is the durable consumer name for Subjects.OrderPlaced
+		OrderPlaced string
+	}
+
+	// Subscriber is a cartDomain.CartEventSubscriber that consumes cart events published by a Publisher
+	// on other nodes of a horizontally-scaled deployment and applies their local side effect
+	Subscriber struct {
+		js               nats.JetStreamContext
+		subjects         Subjects
+		durableConsumers DurableConsumers
+		sessionResolver  cartDomain.SessionResolver
+
+		// mu guards subscriptions, which is mutated by both an explicit Close call and the ctx.Done
+		// goroutine started in Subscribe
+		mu            sync.Mutex
+		subscriptions []*nats.Subscription
+	}
+)
+
+var _ cartDomain.CartEventSubscriber = new(Subscriber)
+
+// ackNaker is the subset of *nats.Msg used by purgeSessionCache, extracted so tests can exercise the
+// Ack/Nak wiring without a live NATS connection
+type ackNaker interface {
+	Ack(opts ...nats.AckOpt) error
+	Nak(opts ...nats.AckOpt) error
+}
+
+// NewSubscriber creates a new Subscriber that consumes events from the given JetStreamContext via
+// durable, at-least-once consumers, and translates them into a local session cache purge using the
+// given SessionResolver
+func NewSubscriber(js nats.JetStreamContext, subjects Subjects, durableConsumers DurableConsumers, sessionResolver cartDomain.SessionResolver) *Subscriber {
+	return &Subscriber{
+		js:               js,
+		subjects:         subjects,
+		durableConsumers: durableConsumers,
+		sessionResolver:  sessionResolver,
+	}
+}
+
+// Subscribe creates the durable JetStream consumers for Subjects.Invalidate, Subjects.Changed and
+// Subjects.OrderPlaced and starts processing events. It returns once every consumer is registered; ctx
+// being cancelled stops processing by calling Close, same as an explicit Close call would
+func (s *Subscriber) Subscribe(ctx context.Context) error {
+	subs := []struct {
+		subject string
+		durable string
+		handler nats.MsgHandler
+	}{
+		{s.subjects.Invalidate, s.durableConsumers.Invalidate, s.handleInvalidation(ctx)},
+		{s.subjects.Changed, s.durableConsumers.Changed, s.handleCartChanged(ctx)},
+		{s.subjects.OrderPlaced, s.durableConsumers.OrderPlaced, s.handleOrderPlaced(ctx)},
+	}
+
+	for _, sub := range subs {
+		subscription, err := s.js.Subscribe(sub.subject, sub.handler, nats.Durable(sub.durable), nats.ManualAck(), nats.AckExplicit())
+		if err != nil {
+			return errors.Wrapf(err, "subscribing to subject %q", sub.subject)
+		}
+		s.mu.Lock()
+		s.subscriptions = append(s.subscriptions, subscription)
+		s.mu.Unlock()
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = s.Close()
+	}()
+
+	return nil
+}
+
+// Close drains every subscription: interest is removed and messages already received keep being handled
+// in the background. It does not wait for that to finish, leaves the NATS connection open, and stops at
+// the first subscription that fails to drain
+func (s *Subscriber) Close() error {
+	s.mu.Lock()
+	subscriptions := s.subscriptions
+	s.subscriptions = nil
+	s.mu.Unlock()
+
+	for _, sub := range subscriptions {
+		if err := sub.Drain(); err != nil {
+			return errors.Wrap(err, "draining subscription")
+		}
+	}
+
+	return nil
+}
+
+// handleInvalidation decodes a CartInvalidationMessage and purges the local session cache entry for it,
+// acknowledging the message only on success so a failed purge is redelivered
+func (s *Subscriber) handleInvalidation(ctx context.Context) nats.MsgHandler {
+	return func(msg *nats.Msg) {
+		var message cartDomain.CartInvalidationMessage
+		if err := json.Unmarshal(msg.Data, &message); err != nil {
+			_ = msg.Nak()
+			return
+		}
+
+		s.purgeSessionCache(ctx, msg, message.CartID, message.AuthenticatedUserID)
+	}
+}
+
+// handleCartChanged decodes a CartChangedEvent and purges the local session cache entry for the
+// CartID it reports changed, so a stale cart is refetched on this node's next request
+func (s *Subscriber) handleCartChanged(ctx context.Context) nats.MsgHandler {
+	return func(msg *nats.Msg) {
+		var event cartDomain.CartChangedEvent
+		if err := json.Unmarshal(msg.Data, &event); err != nil {
+			_ = msg.Nak()
+			return
+		}
+
+		s.purgeSessionCache(ctx, msg, event.CartID, "")
+	}
+}
+
+// handleOrderPlaced decodes an OrderPlacedEvent and purges the local session cache entry for the Cart
+// that was placed, since it no longer reflects a live cart
+func (s *Subscriber) handleOrderPlaced(ctx context.Context) nats.MsgHandler {
+	return func(msg *nats.Msg) {
+		var event cartDomain.OrderPlacedEvent
+		if err := json.Unmarshal(msg.Data, &event); err != nil {
+			_ = msg.Nak()
+			return
+		}
+
+		s.purgeSessionCache(ctx, msg, event.CartID, "")
+	}
+}
+
+// purgeSessionCache resolves the local session for cartID/authenticatedUserID via SessionResolver and
+// deletes its sessionCartCacheKey entry, acking msg only on success so a failed purge is redelivered
+func (s *Subscriber) purgeSessionCache(ctx context.Context, msg ackNaker, cartID string, authenticatedUserID string) {
+	session, err := s.sessionResolver.Resolve(ctx, cartID, authenticatedUserID)
+	if err != nil || session == nil {
+		_ = msg.Nak()
+		return
+	}
+
+	session.Delete(sessionCartCacheKey)
+
+	_ = msg.Ack()
+}
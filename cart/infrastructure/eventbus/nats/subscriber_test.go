@@ -0,0 +1,81 @@
+package nats
+
+import (
+	"context"
+	"testing"
+
+	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo/v3/framework/web"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeSessionResolver struct {
+	session *web.Session
+	err     error
+}
+
+func (f fakeSessionResolver) Resolve(_ context.Context, _ string, _ string) (*web.Session, error) {
+	return f.session, f.err
+}
+
+type fakeAckNaker struct {
+	acked bool
+	naked bool
+}
+
+func (f *fakeAckNaker) Ack(...nats.AckOpt) error {
+	f.acked = true
+	return nil
+}
+
+func (f *fakeAckNaker) Nak(...nats.AckOpt) error {
+	f.naked = true
+	return nil
+}
+
+func TestSubscriber_purgeSessionCache(t *testing.T) {
+	t.Run("acks once the session is resolved and its cache entry deleted", func(t *testing.T) {
+		s := &Subscriber{sessionResolver: fakeSessionResolver{session: &web.Session{}}}
+		msg := &fakeAckNaker{}
+
+		s.purgeSessionCache(context.Background(), msg, "cart-1", "")
+
+		assert.True(t, msg.acked)
+		assert.False(t, msg.naked)
+	})
+
+	t.Run("naks when the resolver errors", func(t *testing.T) {
+		s := &Subscriber{sessionResolver: fakeSessionResolver{err: assert.AnError}}
+		msg := &fakeAckNaker{}
+
+		s.purgeSessionCache(context.Background(), msg, "cart-1", "")
+
+		assert.True(t, msg.naked)
+		assert.False(t, msg.acked)
+	})
+
+	t.Run("naks when the resolver finds no session", func(t *testing.T) {
+		s := &Subscriber{sessionResolver: fakeSessionResolver{}}
+		msg := &fakeAckNaker{}
+
+		s.purgeSessionCache(context.Background(), msg, "cart-1", "")
+
+		assert.True(t, msg.naked)
+		assert.False(t, msg.acked)
+	})
+}
+
+func TestSubscriber_handleInvalidation(t *testing.T) {
+	t.Run("does not panic on a malformed payload", func(t *testing.T) {
+		s := &Subscriber{sessionResolver: fakeSessionResolver{err: assert.AnError}}
+
+		handler := s.handleInvalidation(context.Background())
+
+		assert.NotPanics(t, func() {
+			handler(&nats.Msg{Data: []byte("not json")})
+		})
+	})
+}
+
+var _ cartDomain.SessionResolver = fakeSessionResolver{}
@@ -0,0 +1,49 @@
+package httpadapter
+
+import (
+	"context"
+
+	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+)
+
+type (
+	// CarrierClient must be implemented per carrier (e.g. UPS, USPS, DHL) to fetch live rate quotes for
+	// a Delivery over HTTP. Projects plug in their own carrier specific implementations (e.g. wrapping
+	// a Shippo-style multi-carrier API) without needing to fork Adapter
+	CarrierClient interface {
+		// Carrier returns the carrier name this client fetches quotes for, e.g. "UPS"
+		Carrier() string
+		// FetchRates calls out to the carrier and returns its ShippingRateQuotes for the given delivery
+		FetchRates(ctx context.Context, delivery cartDomain.Delivery) ([]cartDomain.ShippingRateQuote, error)
+	}
+
+	// Adapter is a cartDomain.ShippingRateProvider that rate-shops a Delivery across several
+	// CarrierClients and aggregates their quotes
+	Adapter struct {
+		carriers []CarrierClient
+	}
+)
+
+var _ cartDomain.ShippingRateProvider = new(Adapter)
+
+// NewAdapter creates a new Adapter that rate-shops across the given CarrierClients
+func NewAdapter(carriers ...CarrierClient) *Adapter {
+	return &Adapter{carriers: carriers}
+}
+
+// GetRates queries every configured CarrierClient and returns the combined list of quotes. A single
+// carrier failing to respond does not fail the whole rate shopping - its quotes are simply omitted
+func (a *Adapter) GetRates(ctx context.Context, delivery cartDomain.Delivery) ([]cartDomain.ShippingRateQuote, error) {
+	var quotes []cartDomain.ShippingRateQuote
+
+	for _, carrier := range a.carriers {
+		carrierQuotes, err := carrier.FetchRates(ctx, delivery)
+		if err != nil {
+			continue
+		}
+
+		quotes = append(quotes, carrierQuotes...)
+	}
+
+	return quotes, nil
+}
@@ -0,0 +1,28 @@
+package memory
+
+import (
+	"context"
+
+	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+)
+
+type (
+	// Provider is an in-memory ShippingRateProvider implementation that always returns a fixed, static
+	// set of ShippingRateQuotes - useful for local development and tests before a real carrier
+	// integration (e.g. the httpadapter package) is wired up
+	Provider struct {
+		quotes []cartDomain.ShippingRateQuote
+	}
+)
+
+var _ cartDomain.ShippingRateProvider = new(Provider)
+
+// NewProvider creates a new in-memory Provider that always returns the given static quotes
+func NewProvider(quotes []cartDomain.ShippingRateQuote) *Provider {
+	return &Provider{quotes: quotes}
+}
+
+// GetRates returns the configured static quotes, regardless of the given delivery
+func (p *Provider) GetRates(_ context.Context, _ cartDomain.Delivery) ([]cartDomain.ShippingRateQuote, error) {
+	return p.quotes, nil
+}